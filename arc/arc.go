@@ -0,0 +1,232 @@
+// Package arc implements the Adaptive Replacement Cache (ARC) algorithm as a
+// higher-hit-rate alternative to lru.LRUCache on mixed scan+recency
+// workloads, such as DNS and database query caches.
+//
+// ARC keeps four lists sized around capacity c: T1 (recent, resident), T2
+// (frequent, resident), B1 (recent ghost, keys only) and B2 (frequent ghost,
+// keys only), plus a target size p for T1 that adapts on every ghost hit.
+package arc
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+var ErrKeyNotFound = errors.New("arc key not exist")
+
+type resEntry struct {
+	key   string
+	value interface{}
+}
+
+type Cache struct {
+	mutex sync.Mutex
+	c     int64 //capacity
+	p     int64 //target size of T1, in [0, c]
+
+	t1, t2 *list.List //resident: recent, frequent
+	b1, b2 *list.List //ghost (keys only): recent, frequent
+
+	t1m, t2m map[string]*list.Element //value: *resEntry
+	b1m, b2m map[string]*list.Element //value: key string
+
+	totalReqTimes int64 //total request times
+	totalHitTimes int64 //total hit times
+}
+
+func NewCache(c int) *Cache {
+	return &Cache{
+		c:   int64(c),
+		t1:  list.New(),
+		t2:  list.New(),
+		b1:  list.New(),
+		b2:  list.New(),
+		t1m: make(map[string]*list.Element),
+		t2m: make(map[string]*list.Element),
+		b1m: make(map[string]*list.Element),
+		b2m: make(map[string]*list.Element),
+	}
+}
+
+func (cache *Cache) Get(key string) (interface{}, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.totalReqTimes++
+
+	if ele, ok := cache.t1m[key]; ok { //T1 hit: promote to MRU of T2
+		item := ele.Value.(*resEntry)
+		cache.t1.Remove(ele)
+		delete(cache.t1m, key)
+		cache.t2m[key] = cache.t2.PushBack(item)
+		cache.totalHitTimes++
+		return item.value, nil
+	}
+	if ele, ok := cache.t2m[key]; ok { //T2 hit: stays in T2, moves to MRU
+		cache.t2.MoveToBack(ele)
+		cache.totalHitTimes++
+		return ele.Value.(*resEntry).value, nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+func (cache *Cache) Update(key string, value interface{}) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if ele, ok := cache.t1m[key]; ok { //already resident in T1: refresh and promote
+		item := ele.Value.(*resEntry)
+		item.value = value
+		cache.t1.Remove(ele)
+		delete(cache.t1m, key)
+		cache.t2m[key] = cache.t2.PushBack(item)
+		return nil
+	}
+	if ele, ok := cache.t2m[key]; ok { //already resident in T2: refresh in place
+		ele.Value.(*resEntry).value = value
+		cache.t2.MoveToBack(ele)
+		return nil
+	}
+
+	if ele, ok := cache.b1m[key]; ok { //B1 ghost hit
+		cache.adaptP(true)
+		cache.replace(false)
+		cache.b1.Remove(ele)
+		delete(cache.b1m, key)
+		cache.t2m[key] = cache.t2.PushBack(&resEntry{key: key, value: value})
+		return nil
+	}
+	if ele, ok := cache.b2m[key]; ok { //B2 ghost hit
+		cache.adaptP(false)
+		cache.replace(true)
+		cache.b2.Remove(ele)
+		delete(cache.b2m, key)
+		cache.t2m[key] = cache.t2.PushBack(&resEntry{key: key, value: value})
+		return nil
+	}
+
+	cache.makeRoomForColdMiss()
+	cache.t1m[key] = cache.t1.PushBack(&resEntry{key: key, value: value})
+	return nil
+}
+
+func (cache *Cache) Delete(key string) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if ele, ok := cache.t1m[key]; ok {
+		cache.t1.Remove(ele)
+		delete(cache.t1m, key)
+		return nil
+	}
+	if ele, ok := cache.t2m[key]; ok {
+		cache.t2.Remove(ele)
+		delete(cache.t2m, key)
+		return nil
+	}
+	return ErrKeyNotFound
+}
+
+// makeRoomForColdMiss implements ARC's cold-miss case (IV): x is in none of
+// T1, T2, B1, B2. It grows/shrinks T1∪B1 and T2∪B2 per the standard rules so
+// that inserting x at MRU of T1 keeps all four lists within their bounds.
+func (cache *Cache) makeRoomForColdMiss() {
+	l1 := int64(cache.t1.Len() + cache.b1.Len())
+	l2 := int64(cache.t2.Len() + cache.b2.Len())
+
+	switch {
+	case l1 == cache.c:
+		if int64(cache.t1.Len()) < cache.c {
+			cache.discardLRU(cache.b1, cache.b1m)
+			cache.replace(false)
+		} else {
+			cache.discardLRU(cache.t1, cache.t1m)
+		}
+	case l1 < cache.c && l1+l2 >= cache.c:
+		if l1+l2 == 2*cache.c {
+			cache.discardLRU(cache.b2, cache.b2m)
+		}
+		cache.replace(false)
+	}
+}
+
+// replace evicts the LRU page of T1 to B1, or of T2 to B2, per the standard
+// ARC REPLACE rule. inB2 indicates the request that triggered replacement
+// was a B2 ghost hit, which biases the choice towards evicting from T1.
+func (cache *Cache) replace(inB2 bool) {
+	t1Len := int64(cache.t1.Len())
+	if t1Len >= 1 && (t1Len > cache.p || (inB2 && t1Len == cache.p)) {
+		cache.moveLRUToGhost(cache.t1, cache.t1m, cache.b1, cache.b1m)
+	} else {
+		cache.moveLRUToGhost(cache.t2, cache.t2m, cache.b2, cache.b2m)
+	}
+}
+
+// adaptP adjusts the target size of T1 after a ghost hit in B1 (fromB1) or
+// B2, per the standard ARC adaptation rule.
+func (cache *Cache) adaptP(fromB1 bool) {
+	if fromB1 {
+		delta := ratioOrOne(cache.b2.Len(), cache.b1.Len())
+		cache.p += delta
+		if cache.p > cache.c {
+			cache.p = cache.c
+		}
+	} else {
+		delta := ratioOrOne(cache.b1.Len(), cache.b2.Len())
+		cache.p -= delta
+		if cache.p < 0 {
+			cache.p = 0
+		}
+	}
+}
+
+func ratioOrOne(numerator, denominator int) int64 {
+	if denominator == 0 {
+		return 1
+	}
+	if r := int64(numerator) / int64(denominator); r > 1 {
+		return r
+	}
+	return 1
+}
+
+func (cache *Cache) moveLRUToGhost(fromList *list.List, fromMap map[string]*list.Element, toList *list.List, toMap map[string]*list.Element) {
+	front := fromList.Front()
+	if front == nil {
+		return
+	}
+	item := front.Value.(*resEntry)
+	fromList.Remove(front)
+	delete(fromMap, item.key)
+	toMap[item.key] = toList.PushBack(item.key)
+}
+
+func (cache *Cache) discardLRU(l *list.List, m map[string]*list.Element) {
+	front := l.Front()
+	if front == nil {
+		return
+	}
+	key, ok := front.Value.(string)
+	if !ok {
+		key = front.Value.(*resEntry).key
+	}
+	l.Remove(front)
+	delete(m, key)
+}
+
+func (cache *Cache) GetKeyCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return int64(cache.t1.Len() + cache.t2.Len())
+}
+
+func (cache *Cache) GetTotalReqTimes() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.totalReqTimes
+}
+
+func (cache *Cache) GetTotalHitTimes() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.totalHitTimes
+}
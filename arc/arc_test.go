@@ -0,0 +1,114 @@
+package arc
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestNewCacheConstruction(t *testing.T) {
+	c := NewCache(2)
+	if c.GetKeyCount() != 0 {
+		t.Fatalf("GetKeyCount() = %d, want 0", c.GetKeyCount())
+	}
+}
+
+func TestCacheHitMiss(t *testing.T) {
+	c := NewCache(2)
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(miss) err = %v, want ErrKeyNotFound", err)
+	}
+
+	if err := c.Update("a", 1); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	v, err := c.Get("a")
+	if err != nil || v != 1 {
+		t.Fatalf("Get(a) = (%v, %v), want (1, nil)", v, err)
+	}
+	if got := c.GetTotalReqTimes(); got != 2 {
+		t.Fatalf("GetTotalReqTimes() = %d, want 2", got)
+	}
+	if got := c.GetTotalHitTimes(); got != 1 {
+		t.Fatalf("GetTotalHitTimes() = %d, want 1", got)
+	}
+}
+
+// TestCacheEvictionOrder checks the cold-miss path evicts the LRU of T1 once
+// T1∪B1 reaches capacity, keeping the most recently inserted keys resident.
+func TestCacheEvictionOrder(t *testing.T) {
+	c := NewCache(2)
+	c.Update("a", 1)
+	c.Update("b", 2)
+	c.Update("x", 3) //cold miss, T1 holds c keys: discards a (LRU of T1) without a ghost entry
+
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(a) err = %v, want ErrKeyNotFound (should have been evicted)", err)
+	}
+	if _, err := c.Get("b"); err != nil {
+		t.Fatalf("Get(b) err = %v, want nil", err)
+	}
+	if _, err := c.Get("x"); err != nil {
+		t.Fatalf("Get(x) err = %v, want nil", err)
+	}
+}
+
+// TestCacheGhostHitPromotesToT2 drives b into B1 via replace(), then
+// re-inserts it to check a B1 ghost hit lands the key back in T2.
+func TestCacheGhostHitPromotesToT2(t *testing.T) {
+	c := NewCache(2)
+	c.Update("a", 1)
+	c.Get("a")       //promote a to T2, freeing T1
+	c.Update("b", 2) //T1 has room, goes straight into T1
+	c.Update("x", 3) //cold miss forces replace(): evicts b (LRU of T1) into B1
+
+	if _, ok := c.b1m["b"]; !ok {
+		t.Fatalf("b was not moved to B1 as expected, test setup assumption is wrong")
+	}
+
+	if err := c.Update("b", 20); err != nil { //B1 ghost hit
+		t.Fatalf("Update: %v", err)
+	}
+	if _, ok := c.t2m["b"]; !ok {
+		t.Fatalf("ghost-hit reinsert of b did not land in T2")
+	}
+	v, err := c.Get("b")
+	if err != nil || v != 20 {
+		t.Fatalf("Get(b) = (%v, %v), want (20, nil)", v, err)
+	}
+}
+
+func TestCacheDelete(t *testing.T) {
+	c := NewCache(10)
+	c.Update("a", 1)
+	if err := c.Delete("a"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(a) err = %v, want ErrKeyNotFound after Delete", err)
+	}
+	if err := c.Delete("a"); err != ErrKeyNotFound {
+		t.Fatalf("Delete(missing) err = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestCacheConcurrentAccess(t *testing.T) {
+	c := NewCache(100)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := fmt.Sprintf("key-%d", (worker*1000+j)%50)
+				c.Update(key, j)
+				c.Get(key)
+				c.GetKeyCount()
+				c.GetTotalReqTimes()
+				c.GetTotalHitTimes()
+			}
+		}(i)
+	}
+	wg.Wait()
+}
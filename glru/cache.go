@@ -0,0 +1,61 @@
+package glru
+
+import "sync"
+
+// Cache is a thread-safe wrapper around BasicLRU.
+type Cache[K comparable, V any] struct {
+	mutex sync.Mutex
+	lru   *BasicLRU[K, V]
+}
+
+// NewCache creates a thread-safe generic LRU bounded by maxCount entries,
+// each expiring ttl seconds after its last Update.
+func NewCache[K comparable, V any](maxCount int, ttl int) *Cache[K, V] {
+	return &Cache[K, V]{
+		lru: NewBasicLRU[K, V](maxCount, ttl),
+	}
+}
+
+func (c *Cache[K, V]) Update(key K, value V) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Update(key, value)
+}
+
+func (c *Cache[K, V]) Add(key K, value V) error {
+	return c.Update(key, value)
+}
+
+func (c *Cache[K, V]) Get(key K) (V, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Get(key)
+}
+
+func (c *Cache[K, V]) Delete(key K) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Delete(key)
+}
+
+func (c *Cache[K, V]) Remove(key K) error {
+	return c.Delete(key)
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Len()
+}
+
+func (c *Cache[K, V]) GetReqCount() int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.GetReqCount()
+}
+
+func (c *Cache[K, V]) GetHitCount() int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.GetHitCount()
+}
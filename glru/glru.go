@@ -0,0 +1,164 @@
+// Package glru implements a generics-based LRU cache.
+//
+// Both cache.LRUCache and lru.LRUCache store values as interface{}, which
+// forces callers into type assertions and boxes every value on insert. This
+// package offers the same Update/Get/Delete surface but keeps keys and
+// values in their concrete types, and is the recommended API for new code.
+package glru
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrKeyNotFound = errors.New("glru: key not found")
+
+// node is an element of the internal doubly linked list. Key and value are
+// embedded directly instead of being boxed in an interface{}.
+type node[K comparable, V any] struct {
+	key      K
+	value    V
+	createAt int64 //create unix timestamp
+	prev     *node[K, V]
+	next     *node[K, V]
+}
+
+// BasicLRU is a non-thread-safe, generic LRU cache. Use Cache for concurrent
+// access.
+type BasicLRU[K comparable, V any] struct {
+	maxCount int
+	ttl      int64 //seconds ttl
+	m        map[K]*node[K, V]
+	head     *node[K, V] //front, oldest
+	tail     *node[K, V] //back, newest
+
+	reqCount int64
+	hitCount int64
+}
+
+// NewBasicLRU creates a generic LRU bounded by maxCount entries, each
+// expiring ttl seconds after its last Update.
+func NewBasicLRU[K comparable, V any](maxCount int, ttl int) *BasicLRU[K, V] {
+	return &BasicLRU[K, V]{
+		maxCount: maxCount,
+		ttl:      int64(ttl),
+		m:        make(map[K]*node[K, V], maxCount),
+	}
+}
+
+func (c *BasicLRU[K, V]) unlink(n *node[K, V]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		c.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		c.tail = n.prev
+	}
+	n.prev, n.next = nil, nil
+}
+
+func (c *BasicLRU[K, V]) pushBack(n *node[K, V]) {
+	n.prev = c.tail
+	n.next = nil
+	if c.tail != nil {
+		c.tail.next = n
+	} else {
+		c.head = n
+	}
+	c.tail = n
+}
+
+func (c *BasicLRU[K, V]) moveToBack(n *node[K, V]) {
+	if c.tail == n {
+		return
+	}
+	c.unlink(n)
+	c.pushBack(n)
+}
+
+// Update inserts or refreshes key with value. When the cache is at capacity
+// and key is new, the front (oldest) element is recycled in place instead of
+// being freed and reallocated, so steady-state inserts do not allocate.
+func (c *BasicLRU[K, V]) Update(key K, value V) error {
+	now := time.Now().Unix()
+	if n, ok := c.m[key]; ok {
+		n.value = value
+		n.createAt = now
+		c.moveToBack(n)
+		return nil
+	}
+
+	if c.maxCount > 0 && len(c.m) >= c.maxCount && c.head != nil {
+		n := c.head
+		delete(c.m, n.key)
+		c.unlink(n)
+		n.key = key
+		n.value = value
+		n.createAt = now
+		c.m[key] = n
+		c.pushBack(n)
+		return nil
+	}
+
+	n := &node[K, V]{key: key, value: value, createAt: now}
+	c.m[key] = n
+	c.pushBack(n)
+	return nil
+}
+
+// Add is an alias of Update, kept for callers migrating from container/list
+// based LRUs where "Add" is the conventional insert method name.
+func (c *BasicLRU[K, V]) Add(key K, value V) error {
+	return c.Update(key, value)
+}
+
+// Get returns the value for key and moves it to the back of the list. It
+// does not reset createAt, so a read does not extend the entry's ttl.
+func (c *BasicLRU[K, V]) Get(key K) (V, error) {
+	var zero V
+	c.reqCount++
+	n, ok := c.m[key]
+	if !ok {
+		return zero, ErrKeyNotFound
+	}
+	if n.createAt+c.ttl <= time.Now().Unix() { //expire
+		delete(c.m, key)
+		c.unlink(n)
+		return zero, ErrKeyNotFound
+	}
+	c.hitCount++
+	c.moveToBack(n)
+	return n.value, nil
+}
+
+// Delete removes key from the cache, if present.
+func (c *BasicLRU[K, V]) Delete(key K) error {
+	n, ok := c.m[key]
+	if !ok {
+		return ErrKeyNotFound
+	}
+	delete(c.m, key)
+	c.unlink(n)
+	return nil
+}
+
+// Remove is an alias of Delete.
+func (c *BasicLRU[K, V]) Remove(key K) error {
+	return c.Delete(key)
+}
+
+// Len returns the current number of entries in the cache.
+func (c *BasicLRU[K, V]) Len() int {
+	return len(c.m)
+}
+
+func (c *BasicLRU[K, V]) GetReqCount() int64 {
+	return c.reqCount
+}
+
+func (c *BasicLRU[K, V]) GetHitCount() int64 {
+	return c.hitCount
+}
@@ -0,0 +1,98 @@
+package glru
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBasicLRUConstruction(t *testing.T) {
+	c := NewBasicLRU[string, int](2, 60)
+	if c.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0", c.Len())
+	}
+}
+
+func TestBasicLRUHitMiss(t *testing.T) {
+	c := NewBasicLRU[string, int](2, 60)
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(miss) err = %v, want ErrKeyNotFound", err)
+	}
+
+	if err := c.Update("a", 1); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	v, err := c.Get("a")
+	if err != nil || v != 1 {
+		t.Fatalf("Get(a) = (%v, %v), want (1, nil)", v, err)
+	}
+	if got := c.GetReqCount(); got != 2 {
+		t.Fatalf("GetReqCount() = %d, want 2", got)
+	}
+	if got := c.GetHitCount(); got != 1 {
+		t.Fatalf("GetHitCount() = %d, want 1", got)
+	}
+}
+
+func TestBasicLRUEvictionOrder(t *testing.T) {
+	c := NewBasicLRU[string, int](2, 60)
+	c.Update("a", 1)
+	c.Update("b", 2)
+	c.Get("a") //touch a, so b is now the oldest
+	c.Update("c", 3)
+
+	if _, err := c.Get("b"); err != ErrKeyNotFound {
+		t.Fatalf("Get(b) err = %v, want ErrKeyNotFound (should have been evicted)", err)
+	}
+	if _, err := c.Get("a"); err != nil {
+		t.Fatalf("Get(a) err = %v, want nil", err)
+	}
+	if _, err := c.Get("c"); err != nil {
+		t.Fatalf("Get(c) err = %v, want nil", err)
+	}
+}
+
+func TestBasicLRUExpiry(t *testing.T) {
+	c := NewBasicLRU[string, int](10, 0)
+	c.Update("a", 1)
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := c.Get("a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(a) err = %v, want ErrKeyNotFound after ttl expiry", err)
+	}
+}
+
+func TestBasicLRURecyclesNodeAtCapacity(t *testing.T) {
+	c := NewBasicLRU[string, int](1, 60)
+	c.Update("a", 1)
+	n := c.head
+	c.Update("b", 2) //evicts a, should recycle the same node
+
+	if c.head != n {
+		t.Fatalf("Update at capacity allocated a new node instead of recycling")
+	}
+	if v, err := c.Get("b"); err != nil || v != 2 {
+		t.Fatalf("Get(b) = (%v, %v), want (2, nil)", v, err)
+	}
+}
+
+func TestCacheConcurrentAccess(t *testing.T) {
+	c := NewCache[string, int](100, 60)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := fmt.Sprintf("key-%d", (worker*1000+j)%50)
+				c.Update(key, j)
+				c.Get(key)
+				c.GetReqCount()
+				c.GetHitCount()
+				c.Len()
+			}
+		}(i)
+	}
+	wg.Wait()
+}
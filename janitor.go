@@ -0,0 +1,57 @@
+package cache
+
+import "time"
+
+// startJanitor runs a background sweep every interval that removes expired
+// entries without waiting for a Get or a capacity check to find them. The
+// list is ordered by touch time, not expiry time (per-entry ttl and
+// MoveToBack on read both break any expiry ordering), so the sweep walks the
+// whole list on every tick rather than stopping at the first live entry.
+func (cache *LRUCache) startJanitor(interval time.Duration) {
+	cache.stopCh = make(chan struct{})
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				cache.sweepExpired()
+			case <-cache.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (cache *LRUCache) sweepExpired() {
+	now := time.Now().Unix()
+
+	cache.mutex.Lock()
+	var evicted []*entry
+	for ele := cache.lruList.Front(); ele != nil; {
+		next := ele.Next()
+		item := ele.Value.(*entry)
+		if item.expireAt <= now {
+			cache.lruList.Remove(ele)
+			delete(cache.lruMap, item.key)
+			cache.keyCount--
+			cache.currentBytes -= item.size
+			evicted = append(evicted, item)
+		}
+		ele = next
+	}
+	cache.mutex.Unlock()
+
+	cache.fireEvicted(evicted, EvictExpired)
+}
+
+// Close stops the background janitor started by SweepInterval, if any. It is
+// a no-op on a cache created without one.
+func (cache *LRUCache) Close() {
+	if cache.stopCh == nil {
+		return
+	}
+	cache.closeOnce.Do(func() {
+		close(cache.stopCh)
+	})
+}
@@ -0,0 +1,33 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestJanitorSweepsExpiredEntriesWithoutReads(t *testing.T) {
+	cache := NewLRUCacheWithOptions(1000, 1, Options{SweepInterval: 20 * time.Millisecond})
+	defer cache.Close()
+
+	for i := 0; i < 100; i++ {
+		if err := cache.Update(fmt.Sprintf("key-%d", i), i); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+	}
+	if got := cache.GetKeyCount(); got != 100 {
+		t.Fatalf("GetKeyCount() before expiry = %d, want 100", got)
+	}
+
+	time.Sleep(1200 * time.Millisecond) //past the 1s ttl plus a couple of sweep intervals
+
+	if got := cache.GetKeyCount(); got != 0 {
+		t.Fatalf("GetKeyCount() after expiry = %d, want 0 (janitor should free memory without any Get)", got)
+	}
+}
+
+func TestCloseStopsJanitor(t *testing.T) {
+	cache := NewLRUCacheWithOptions(10, 60, Options{SweepInterval: 10 * time.Millisecond})
+	cache.Close()
+	cache.Close() //must be safe to call twice
+}
@@ -0,0 +1,217 @@
+package lru
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrLruNotFoundKey = errors.New("lru key not exist")
+
+type LRUCache struct {
+	mutex     sync.Mutex
+	maxCount  int64                    //max cache key counts
+	maxBytes  int64                    //max cache value bytes, 0 means unbounded by size
+	ttl       int64                    //seconds ttl, used when an entry has no ttl of its own
+	lruList   *list.List               //list
+	lruMap    map[string]*list.Element //map
+	onEvict   OnEvictFunc              //optional eviction callback, see Options
+	stopCh    chan struct{}            //closed by Close to stop the janitor, nil if none was started
+	closeOnce sync.Once
+
+	reqCount     int64 //request counts
+	hitCount     int64 //hit counts
+	keyCount     int64 //current cache key counts
+	currentBytes int64 //current cache value bytes
+}
+
+type entry struct {
+	key         string
+	value       interface{}
+	createAt    int64       //create unix timestamp
+	expireAt    int64       //unix timestamp this entry expires at
+	size        int64       //value size in bytes, 0 when the cache is not size-bounded
+	evictReason EvictReason //set by checkWithLocked or sweepExpired when this entry is removed
+}
+
+func NewLRUCache(maxCount int, ttl int) *LRUCache {
+	cache := &LRUCache{
+		maxCount: int64(maxCount),
+		ttl:      int64(ttl),
+		lruList:  list.New(),
+		lruMap:   make(map[string]*list.Element),
+	}
+	return cache
+}
+
+func (cache *LRUCache) Update(key string, value interface{}) error {
+	return cache.update(key, value, cache.ttl)
+}
+
+// UpdateWithTTL is like Update but expires key after ttl instead of the
+// cache's default ttl. ttl <= 0 falls back to the cache default. Sub-second
+// durations round up to 1 second rather than truncating to 0, since the
+// cache only tracks expiry at second resolution.
+func (cache *LRUCache) UpdateWithTTL(key string, value interface{}, ttl time.Duration) error {
+	var ttlSeconds int64
+	if ttl > 0 {
+		ttlSeconds = int64((ttl + time.Second - 1) / time.Second)
+	}
+	return cache.update(key, value, ttlSeconds)
+}
+
+func (cache *LRUCache) update(key string, value interface{}, ttlSeconds int64) error {
+	if ttlSeconds <= 0 {
+		ttlSeconds = cache.ttl
+	}
+	now := time.Now().Unix()
+
+	cache.mutex.Lock()
+	if ele, ok := cache.lruMap[key]; ok { //exist
+		item := ele.Value.(*entry)
+		cache.currentBytes -= item.size
+		item.value = value
+		item.createAt = now
+		item.expireAt = now + ttlSeconds
+		item.size = 0
+		cache.lruList.MoveToBack(ele)
+		cache.mutex.Unlock()
+		return nil
+	}
+	//new
+	item := &entry{
+		key:      key,
+		value:    value,
+		createAt: now,
+		expireAt: now + ttlSeconds,
+	}
+	cache.lruMap[key] = cache.lruList.PushBack(item)
+	cache.keyCount++
+	evicted := cache.checkWithLocked()
+	cache.mutex.Unlock()
+	cache.fireEvicted(evicted)
+	return nil
+}
+
+func (cache *LRUCache) Get(key string) (interface{}, error) {
+	cache.mutex.Lock()
+	cache.reqCount++
+	ele, ok := cache.lruMap[key]
+	if !ok {
+		evicted := cache.checkWithLocked()
+		cache.mutex.Unlock()
+		cache.fireEvicted(evicted)
+		return nil, ErrLruNotFoundKey
+	}
+
+	item := ele.Value.(*entry)
+	hit := item.expireAt > time.Now().Unix()
+	var value interface{}
+	if hit {
+		cache.hitCount++
+		cache.lruList.MoveToBack(ele)
+		value = item.value
+	} else {
+		//expire
+		cache.lruList.Remove(ele)
+		delete(cache.lruMap, item.key)
+		cache.keyCount--
+		cache.currentBytes -= item.size
+	}
+	evicted := cache.checkWithLocked()
+	cache.mutex.Unlock()
+
+	if !hit {
+		cache.fireEvict(item, EvictExpired)
+	}
+	cache.fireEvicted(evicted)
+	if !hit {
+		return nil, ErrLruNotFoundKey
+	}
+	return value, nil
+}
+
+func (cache *LRUCache) Delete(key string) error {
+	cache.mutex.Lock()
+	ele, ok := cache.lruMap[key]
+	if !ok {
+		cache.mutex.Unlock()
+		return ErrLruNotFoundKey
+	}
+	item := ele.Value.(*entry)
+	cache.lruList.Remove(ele)
+	delete(cache.lruMap, key)
+	cache.keyCount--
+	cache.currentBytes -= item.size
+	cache.mutex.Unlock()
+	cache.fireEvict(item, EvictManual)
+	return nil
+}
+
+// checkWithLocked walks the list from the front, which is ordered by touch
+// time, evicting entries until the front one is unexpired and the
+// key-count/byte-size bounds hold; expired entries behind that front entry
+// are not removed here. It returns what it evicted so the caller can fire
+// eviction callbacks once the mutex is released.
+func (cache *LRUCache) checkWithLocked() []*entry {
+	var evicted []*entry
+	now := time.Now().Unix()
+	for cache.lruList.Front() != nil {
+		front := cache.lruList.Front()
+		e := front.Value.(*entry)
+		expired := now >= e.expireAt
+		underCount := cache.maxCount <= 0 || cache.keyCount <= cache.maxCount
+		//key count not greater, byte size not greater, and key not expired
+		if !expired && underCount && cache.underBytes() {
+			break
+		}
+		cache.lruList.Remove(front)
+		delete(cache.lruMap, e.key)
+		cache.keyCount--
+		cache.currentBytes -= e.size
+		evicted = append(evicted, e)
+		if expired {
+			e.evictReason = EvictExpired
+		} else {
+			e.evictReason = EvictCapacity
+		}
+	}
+	return evicted
+}
+
+// underBytes reports whether the cache is within its byte-size bound.
+// maxBytes of 0 means the cache is not size-bounded.
+func (cache *LRUCache) underBytes() bool {
+	return cache.maxBytes <= 0 || cache.currentBytes <= cache.maxBytes
+}
+
+func (cache *LRUCache) fireEvict(item *entry, reason EvictReason) {
+	if cache.onEvict != nil {
+		cache.onEvict(item.key, item.value, reason)
+	}
+}
+
+func (cache *LRUCache) fireEvicted(items []*entry) {
+	for _, item := range items {
+		cache.fireEvict(item, item.evictReason)
+	}
+}
+
+func (cache *LRUCache) GetReqCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.reqCount
+}
+
+func (cache *LRUCache) GetHitCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.hitCount
+}
+
+func (cache *LRUCache) GetKeysCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.keyCount
+}
@@ -0,0 +1,39 @@
+package lru
+
+import "time"
+
+// EvictReason identifies why an entry left the cache, passed to OnEvictFunc.
+type EvictReason int
+
+const (
+	EvictCapacity EvictReason = iota //evicted to stay within maxCount/maxBytes
+	EvictExpired                     //evicted because its ttl elapsed
+	EvictManual                      //evicted by an explicit removal
+)
+
+// OnEvictFunc is invoked whenever an entry leaves the cache. It runs with the
+// cache's mutex released, so it may safely call back into the cache.
+type OnEvictFunc func(key string, value interface{}, reason EvictReason)
+
+// Options configures an LRUCache created via NewLRUCacheWithOptions.
+type Options struct {
+	OnEvict OnEvictFunc
+
+	// SweepInterval, when positive, starts a background goroutine that
+	// proactively removes expired entries every interval, so a cache with
+	// many never-re-read keys doesn't hold memory past ttl. Call Close to
+	// stop it.
+	SweepInterval time.Duration
+}
+
+// NewLRUCacheWithOptions is like NewLRUCache but accepts an Options struct
+// for features that don't belong on the plain constructor, such as an
+// eviction callback or a background sweeper.
+func NewLRUCacheWithOptions(maxCount int, ttl int, opts Options) *LRUCache {
+	cache := NewLRUCache(maxCount, ttl)
+	cache.onEvict = opts.OnEvict
+	if opts.SweepInterval > 0 {
+		cache.startJanitor(opts.SweepInterval)
+	}
+	return cache
+}
@@ -10,12 +10,17 @@ import (
 var ErrKeyNotFound = errors.New("key not found")
 
 type LRUCache struct {
-	mutex    sync.Mutex
-	maxCount int64                    //max cache key counts
-	ttl      int64                    //seconds ttl
-	lruList  *list.List               //list
-	lruMap   map[string]*list.Element //map
-	keyCount int64                    //current cache key counts
+	mutex        sync.Mutex
+	maxCount     int64                    //max cache key counts, 0 means unbounded by count
+	maxBytes     int64                    //max cache value bytes, 0 means unbounded by size
+	ttl          int64                    //seconds ttl, used when an entry has no ttl of its own
+	lruList      *list.List               //list
+	lruMap       map[string]*list.Element //map
+	keyCount     int64                    //current cache key counts
+	currentBytes int64                    //current cache value bytes
+	onEvict      OnEvictFunc              //optional eviction callback, see Options
+	stopCh       chan struct{}            //closed by Close to stop the janitor, nil if none was started
+	closeOnce    sync.Once
 
 	totalReqTimes int64 //total request times
 	totalHitTimes int64 //total hit times
@@ -25,6 +30,8 @@ type entry struct {
 	key      string
 	value    interface{}
 	createAt int64 //create unix timestamp
+	expireAt int64 //unix timestamp this entry expires at
+	size     int64 //value size in bytes, 0 when the cache is not size-bounded
 }
 
 func NewLRUCache(maxCount int, ttl int) *LRUCache {
@@ -38,77 +45,152 @@ func NewLRUCache(maxCount int, ttl int) *LRUCache {
 }
 
 func (cache *LRUCache) Update(key string, value interface{}) error {
+	return cache.update(key, value, cache.ttl)
+}
+
+// UpdateWithTTL is like Update but expires key after ttl instead of the
+// cache's default ttl. ttl <= 0 falls back to the cache default. Sub-second
+// durations round up to 1 second rather than truncating to 0, since the
+// cache only tracks expiry at second resolution.
+func (cache *LRUCache) UpdateWithTTL(key string, value interface{}, ttl time.Duration) error {
+	var ttlSeconds int64
+	if ttl > 0 {
+		ttlSeconds = int64((ttl + time.Second - 1) / time.Second)
+	}
+	return cache.update(key, value, ttlSeconds)
+}
+
+func (cache *LRUCache) update(key string, value interface{}, ttlSeconds int64) error {
+	if ttlSeconds <= 0 {
+		ttlSeconds = cache.ttl
+	}
+	now := time.Now().Unix()
+
 	cache.mutex.Lock()
-	defer cache.mutex.Unlock()
 	if ele, ok := cache.lruMap[key]; ok { //exist
 		item := ele.Value.(*entry)
+		cache.currentBytes -= item.size
 		item.value = value
-		item.createAt = time.Now().Unix()
+		item.createAt = now
+		item.expireAt = now + ttlSeconds
+		item.size = 0
 		cache.lruList.MoveToBack(ele)
-	} else { //new
-		item := &entry{
-			key:      key,
-			value:    value,
-			createAt: time.Now().Unix(),
-		}
-		cache.lruMap[key] = cache.lruList.PushBack(item)
-		cache.keyCount++
-		cache.checkWithLocked()
+		cache.mutex.Unlock()
+		return nil
 	}
+	//new
+	item := &entry{
+		key:      key,
+		value:    value,
+		createAt: now,
+		expireAt: now + ttlSeconds,
+	}
+	cache.lruMap[key] = cache.lruList.PushBack(item)
+	cache.keyCount++
+	evicted := cache.checkWithLocked()
+	cache.mutex.Unlock()
+	cache.fireEvicted(evicted, EvictCapacity)
 	return nil
 }
 
 func (cache *LRUCache) Get(key string) (interface{}, error) {
 	cache.mutex.Lock()
-	defer cache.mutex.Unlock()
 	cache.totalReqTimes++
-	if ele, ok := cache.lruMap[key]; ok {
-		item := ele.Value.(*entry)
-		if item.createAt+cache.ttl > time.Now().Unix() { //有效
-			cache.totalHitTimes++
-			cache.lruList.MoveToBack(ele)
-			return item.value, nil
-		}
-		//expire
-		cache.lruList.Remove(ele)
-		delete(cache.lruMap, key)
-		cache.keyCount--
+	ele, ok := cache.lruMap[key]
+	if !ok {
+		cache.mutex.Unlock()
+		return nil, ErrKeyNotFound
 	}
+	item := ele.Value.(*entry)
+	if item.expireAt > time.Now().Unix() { //有效
+		cache.totalHitTimes++
+		cache.lruList.MoveToBack(ele)
+		value := item.value
+		cache.mutex.Unlock()
+		return value, nil
+	}
+	//expire
+	cache.lruList.Remove(ele)
+	delete(cache.lruMap, key)
+	cache.keyCount--
+	cache.currentBytes -= item.size
+	cache.mutex.Unlock()
+	cache.fireEvict(item, EvictExpired)
 	return nil, ErrKeyNotFound
 }
 
 func (cache *LRUCache) Delete(key string) error {
 	cache.mutex.Lock()
-	defer cache.mutex.Unlock()
 	cache.totalReqTimes++
-	if ele, ok := cache.lruMap[key]; ok {
-		cache.totalHitTimes++
-		cache.lruList.Remove(ele)
-		delete(cache.lruMap, key)
-		cache.keyCount--
-		return nil
+	ele, ok := cache.lruMap[key]
+	if !ok {
+		cache.mutex.Unlock()
+		return ErrKeyNotFound
 	}
-	return ErrKeyNotFound
+	item := ele.Value.(*entry)
+	cache.totalHitTimes++
+	cache.lruList.Remove(ele)
+	delete(cache.lruMap, key)
+	cache.keyCount--
+	cache.currentBytes -= item.size
+	cache.mutex.Unlock()
+	cache.fireEvict(item, EvictManual)
+	return nil
 }
 
-func (cache *LRUCache) checkWithLocked() {
-	for cache.keyCount > cache.maxCount && cache.lruList.Front() != nil {
+// checkWithLocked evicts entries from the front of the list until the cache
+// is within its key-count and byte-size bounds, and returns what it evicted.
+// Callers must hold cache.mutex and must fire eviction callbacks only after
+// releasing it.
+func (cache *LRUCache) checkWithLocked() []*entry {
+	var evicted []*entry
+	for cache.lruList.Front() != nil && cache.isOverCapacity() {
 		front := cache.lruList.Front()
 		item := front.Value.(*entry)
 		cache.lruList.Remove(front)
 		delete(cache.lruMap, item.key)
 		cache.keyCount--
+		cache.currentBytes -= item.size
+		evicted = append(evicted, item)
+	}
+	return evicted
+}
+
+// isOverCapacity reports whether the cache is over its key-count bound, its
+// byte-size bound, or both. maxCount of 0 means the cache is not bounded by
+// key count; maxBytes of 0 means it is not size-bounded.
+func (cache *LRUCache) isOverCapacity() bool {
+	overCount := cache.maxCount > 0 && cache.keyCount > cache.maxCount
+	overBytes := cache.maxBytes > 0 && cache.currentBytes > cache.maxBytes
+	return overCount || overBytes
+}
+
+func (cache *LRUCache) fireEvict(item *entry, reason EvictReason) {
+	if cache.onEvict != nil {
+		cache.onEvict(item.key, item.value, reason)
+	}
+}
+
+func (cache *LRUCache) fireEvicted(items []*entry, reason EvictReason) {
+	for _, item := range items {
+		cache.fireEvict(item, reason)
 	}
 }
 
 func (cache *LRUCache) GetKeyCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
 	return cache.keyCount
 }
 
 func (cache *LRUCache) GetTotalReqTimes() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
 	return cache.totalReqTimes
 }
 
 func (cache *LRUCache) GetTotalHitTimes() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
 	return cache.totalHitTimes
 }
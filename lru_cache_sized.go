@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"errors"
+	"time"
+)
+
+// NewSizedLRUCache creates an LRUCache bounded by total value size in bytes
+// rather than key count. Use UpdateSized to insert values so their size is
+// tracked; plain Update treats the inserted value as zero-sized.
+func NewSizedLRUCache(maxBytes int64, ttl int) *LRUCache {
+	cache := NewLRUCache(0, ttl) //maxCount of 0 means unbounded by key count
+	cache.maxBytes = maxBytes
+	return cache
+}
+
+var ErrNegativeSize = errors.New("size must be >= 0")
+
+// UpdateSized inserts or refreshes key with value, tracking size bytes
+// against the cache's maxBytes bound. checkWithLocked evicts from the front
+// of the list until both the key-count and byte-size bounds are satisfied.
+func (cache *LRUCache) UpdateSized(key string, value interface{}, size int) error {
+	if size < 0 {
+		return ErrNegativeSize
+	}
+	now := time.Now().Unix()
+
+	cache.mutex.Lock()
+	if ele, ok := cache.lruMap[key]; ok { //exist
+		item := ele.Value.(*entry)
+		cache.currentBytes += int64(size) - item.size
+		item.value = value
+		item.createAt = now
+		item.expireAt = now + cache.ttl
+		item.size = int64(size)
+		cache.lruList.MoveToBack(ele)
+	} else { //new
+		item := &entry{
+			key:      key,
+			value:    value,
+			createAt: now,
+			expireAt: now + cache.ttl,
+			size:     int64(size),
+		}
+		cache.lruMap[key] = cache.lruList.PushBack(item)
+		cache.keyCount++
+		cache.currentBytes += int64(size)
+	}
+	evicted := cache.checkWithLocked()
+	cache.mutex.Unlock()
+	cache.fireEvicted(evicted, EvictCapacity)
+	return nil
+}
+
+func (cache *LRUCache) GetCurrentBytes() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.currentBytes
+}
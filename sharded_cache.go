@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"hash/fnv"
+	"runtime"
+	"time"
+)
+
+// ShardedLRUCache wraps N independent LRUCache shards so that Get, which
+// holds the shard's mutex across a MoveToBack, no longer serializes readers
+// across every key. Each shard is sized maxCount/N, and keys are routed to a
+// shard by FNV hash. It has LRUCache's Update, UpdateWithTTL, Get, Delete and
+// counter methods; it has no UpdateSized, GetCurrentBytes or Close.
+type ShardedLRUCache struct {
+	shards []*LRUCache
+	mask   uint32
+}
+
+// NewShardedLRUCache creates a ShardedLRUCache with shardCount shards, each
+// holding up to maxCount/shardCount keys with the given ttl. shardCount <= 0
+// defaults to runtime.GOMAXPROCS(0); it is rounded up to the next power of
+// two so keys can be routed with a bitmask.
+func NewShardedLRUCache(maxCount int, ttl int, shardCount int) *ShardedLRUCache {
+	if shardCount <= 0 {
+		shardCount = runtime.GOMAXPROCS(0)
+	}
+	n := nextPowerOfTwo(shardCount)
+	shards := make([]*LRUCache, n)
+	perShard := maxCount / n
+	if perShard <= 0 {
+		perShard = 1
+	}
+	for i := range shards {
+		shards[i] = NewLRUCache(perShard, ttl)
+	}
+	return &ShardedLRUCache{
+		shards: shards,
+		mask:   uint32(n - 1),
+	}
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+func (s *ShardedLRUCache) shardFor(key string) *LRUCache {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return s.shards[h.Sum32()&s.mask]
+}
+
+func (s *ShardedLRUCache) Update(key string, value interface{}) error {
+	return s.shardFor(key).Update(key, value)
+}
+
+func (s *ShardedLRUCache) UpdateWithTTL(key string, value interface{}, ttl time.Duration) error {
+	return s.shardFor(key).UpdateWithTTL(key, value, ttl)
+}
+
+func (s *ShardedLRUCache) Get(key string) (interface{}, error) {
+	return s.shardFor(key).Get(key)
+}
+
+func (s *ShardedLRUCache) Delete(key string) error {
+	return s.shardFor(key).Delete(key)
+}
+
+func (s *ShardedLRUCache) GetKeyCount() int64 {
+	var total int64
+	for _, shard := range s.shards {
+		total += shard.GetKeyCount()
+	}
+	return total
+}
+
+func (s *ShardedLRUCache) GetTotalReqTimes() int64 {
+	var total int64
+	for _, shard := range s.shards {
+		total += shard.GetTotalReqTimes()
+	}
+	return total
+}
+
+func (s *ShardedLRUCache) GetTotalHitTimes() int64 {
+	var total int64
+	for _, shard := range s.shards {
+		total += shard.GetTotalHitTimes()
+	}
+	return total
+}
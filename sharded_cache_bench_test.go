@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+)
+
+const benchKeyCount = 10000
+
+func benchmarkConcurrentGet(b *testing.B, get func(key string) (interface{}, error)) {
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			get(fmt.Sprintf("key-%d", i%benchKeyCount))
+			i++
+		}
+	})
+}
+
+func BenchmarkLRUCacheConcurrentGet(b *testing.B) {
+	cache := NewLRUCache(benchKeyCount, 60)
+	for i := 0; i < benchKeyCount; i++ {
+		cache.Update(fmt.Sprintf("key-%d", i), i)
+	}
+	benchmarkConcurrentGet(b, cache.Get)
+}
+
+func BenchmarkShardedLRUCacheConcurrentGet(b *testing.B) {
+	cache := NewShardedLRUCache(benchKeyCount, 60, 16)
+	for i := 0; i < benchKeyCount; i++ {
+		cache.Update(fmt.Sprintf("key-%d", i), i)
+	}
+	benchmarkConcurrentGet(b, cache.Get)
+}
@@ -0,0 +1,167 @@
+// Package sieve implements the SIEVE eviction algorithm as a drop-in
+// alternative to lru.LRUCache for read-heavy traffic.
+//
+// Unlike LRU, a hit does not move the entry in the list; it only flips a
+// "visited" bit. This removes the list-splice cost from the read path and,
+// on skewed workloads, tends to match or beat LRU's hit rate.
+package sieve
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrNotFoundKey = errors.New("sieve key not exist")
+
+type Cache struct {
+	mutex    sync.Mutex
+	maxCount int64                    //max cache key counts
+	ttl      int64                    //seconds ttl
+	lruList  *list.List               //list, front = newest, back = oldest
+	lruMap   map[string]*list.Element //map
+	hand     *list.Element            //sieve hand, nil means start from the back
+
+	reqCount int64 //request counts
+	hitCount int64 //hit counts
+	keyCount int64 //current cache key counts
+}
+
+type entry struct {
+	key      string
+	value    interface{}
+	createAt int64 //create unix timestamp
+	visited  bool
+}
+
+func NewCache(maxCount int, ttl int) *Cache {
+	return &Cache{
+		maxCount: int64(maxCount),
+		ttl:      int64(ttl),
+		lruList:  list.New(),
+		lruMap:   make(map[string]*list.Element),
+	}
+}
+
+func (cache *Cache) Update(key string, value interface{}) error {
+	cache.mutex.Lock()
+	defer func() {
+		cache.checkWithLocked()
+		cache.mutex.Unlock()
+	}()
+	if ele, ok := cache.lruMap[key]; ok { //exist, treat update as an access
+		item := ele.Value.(*entry)
+		item.value = value
+		item.createAt = time.Now().Unix()
+		item.visited = true
+	} else { //new
+		item := &entry{
+			key:      key,
+			value:    value,
+			createAt: time.Now().Unix(),
+		}
+		cache.lruMap[key] = cache.lruList.PushFront(item)
+		cache.keyCount++
+	}
+	return nil
+}
+
+func (cache *Cache) Get(key string) (interface{}, error) {
+	cache.mutex.Lock()
+	defer func() {
+		cache.checkWithLocked()
+		cache.mutex.Unlock()
+	}()
+	cache.reqCount++
+	if ele, ok := cache.lruMap[key]; ok {
+		item := ele.Value.(*entry)
+		if item.createAt+cache.ttl > time.Now().Unix() { //有效
+			cache.hitCount++
+			item.visited = true
+			return item.value, nil
+		}
+		//expire
+		cache.removeElement(ele)
+	}
+	return nil, ErrNotFoundKey
+}
+
+func (cache *Cache) Delete(key string) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if ele, ok := cache.lruMap[key]; ok {
+		cache.removeElement(ele)
+		return nil
+	}
+	return ErrNotFoundKey
+}
+
+// removeElement drops ele from the list and map, fixing up the hand if it
+// currently points at ele.
+func (cache *Cache) removeElement(ele *list.Element) {
+	item := ele.Value.(*entry)
+	if cache.hand == ele {
+		cache.hand = cache.advanceHand(ele)
+	}
+	cache.lruList.Remove(ele)
+	delete(cache.lruMap, item.key)
+	cache.keyCount--
+}
+
+// advanceHand returns the element the hand should move to after ele is
+// evicted or removed: ele's predecessor toward the head, wrapping to the
+// back when ele was the front, or nil when ele is the only element.
+func (cache *Cache) advanceHand(ele *list.Element) *list.Element {
+	if prev := ele.Prev(); prev != nil {
+		return prev
+	}
+	if back := cache.lruList.Back(); back != ele {
+		return back
+	}
+	return nil
+}
+
+func (cache *Cache) checkWithLocked() {
+	for cache.keyCount > cache.maxCount && cache.lruList.Len() > 0 {
+		e := cache.hand
+		if e == nil {
+			e = cache.lruList.Back()
+		}
+		for {
+			item := e.Value.(*entry)
+			if !item.visited {
+				break
+			}
+			item.visited = false
+			if prev := e.Prev(); prev != nil {
+				e = prev
+			} else {
+				e = cache.lruList.Back()
+			}
+		}
+		cache.hand = cache.advanceHand(e)
+		item := e.Value.(*entry)
+		cache.lruList.Remove(e)
+		delete(cache.lruMap, item.key)
+		cache.keyCount--
+	}
+}
+
+func (cache *Cache) GetReqCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.reqCount
+}
+
+func (cache *Cache) GetHitCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.hitCount
+}
+
+func (cache *Cache) GetKeysCount() int64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.keyCount
+}
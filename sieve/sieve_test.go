@@ -0,0 +1,101 @@
+package sieve
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewCacheConstruction(t *testing.T) {
+	c := NewCache(2, 60)
+	if c.GetKeysCount() != 0 {
+		t.Fatalf("GetKeysCount() = %d, want 0", c.GetKeysCount())
+	}
+}
+
+func TestCacheHitMiss(t *testing.T) {
+	c := NewCache(2, 60)
+	if _, err := c.Get("a"); err != ErrNotFoundKey {
+		t.Fatalf("Get(miss) err = %v, want ErrNotFoundKey", err)
+	}
+
+	if err := c.Update("a", 1); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	v, err := c.Get("a")
+	if err != nil || v != 1 {
+		t.Fatalf("Get(a) = (%v, %v), want (1, nil)", v, err)
+	}
+	if got := c.GetReqCount(); got != 2 {
+		t.Fatalf("GetReqCount() = %d, want 2", got)
+	}
+	if got := c.GetHitCount(); got != 1 {
+		t.Fatalf("GetHitCount() = %d, want 1", got)
+	}
+}
+
+// TestCacheEvictionOrder checks the SIEVE hand: a gets visited by a Get
+// before c is inserted, so it survives the sweep in b's favor even though a
+// was inserted before b.
+func TestCacheEvictionOrder(t *testing.T) {
+	c := NewCache(2, 60)
+	c.Update("a", 1)
+	c.Update("b", 2)
+	c.Get("a") //mark a visited, so the hand skips it and evicts b instead
+	c.Update("x", 3)
+
+	if _, err := c.Get("b"); err != ErrNotFoundKey {
+		t.Fatalf("Get(b) err = %v, want ErrNotFoundKey (should have been evicted)", err)
+	}
+	if _, err := c.Get("a"); err != nil {
+		t.Fatalf("Get(a) err = %v, want nil", err)
+	}
+	if _, err := c.Get("x"); err != nil {
+		t.Fatalf("Get(x) err = %v, want nil", err)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	c := NewCache(10, 0)
+	c.Update("a", 1)
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := c.Get("a"); err != ErrNotFoundKey {
+		t.Fatalf("Get(a) err = %v, want ErrNotFoundKey after ttl expiry", err)
+	}
+}
+
+func TestCacheDelete(t *testing.T) {
+	c := NewCache(10, 60)
+	c.Update("a", 1)
+	if err := c.Delete("a"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := c.Get("a"); err != ErrNotFoundKey {
+		t.Fatalf("Get(a) err = %v, want ErrNotFoundKey after Delete", err)
+	}
+	if err := c.Delete("a"); err != ErrNotFoundKey {
+		t.Fatalf("Delete(missing) err = %v, want ErrNotFoundKey", err)
+	}
+}
+
+func TestCacheConcurrentAccess(t *testing.T) {
+	c := NewCache(100, 60)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := fmt.Sprintf("key-%d", (worker*1000+j)%50)
+				c.Update(key, j)
+				c.Get(key)
+				c.GetReqCount()
+				c.GetHitCount()
+				c.GetKeysCount()
+			}
+		}(i)
+	}
+	wg.Wait()
+}